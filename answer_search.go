@@ -0,0 +1,46 @@
+package main
+
+import "math"
+
+// BinarySearchAnswer 在闭区间 [lo, hi] 上查找满足单调谓词 pred 的最小整数
+// pred 必须关于 x 单调：存在某个分界点，x 小于分界点时 pred(x) 为 false，
+// 大于等于分界点时为 true（典型场景如“D 天内运完所有包裹的最小运力”）
+// 若整个区间内 pred 均为 false，返回 hi + 1 表示未找到
+func BinarySearchAnswer(lo, hi int, pred func(x int) bool) int {
+	result := hi + 1
+
+	for lo <= hi {
+		mid := lo + (hi-lo)/2
+
+		if pred(mid) {
+			result = mid
+			hi = mid - 1
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	return result
+}
+
+// maxAnswerIterations 是浮点版本的收敛保护，避免 pred 不严格单调或精度问题导致死循环
+const maxAnswerIterations = 200
+
+// BinarySearchAnswerFloat 在闭区间 [lo, hi] 上查找满足单调谓词 pred 的最小实数，
+// 精度由 eps 控制，同时以 maxAnswerIterations 作为迭代次数上限兜底
+func BinarySearchAnswerFloat(lo, hi, eps float64, pred func(x float64) bool) float64 {
+	for i := 0; i < maxAnswerIterations && hi-lo > eps; i++ {
+		mid := lo + (hi-lo)/2
+
+		if pred(mid) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+
+	if pred(hi) {
+		return hi
+	}
+	return math.NaN()
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// TestBinarySearchAnswerProperty 随机生成单调谓词（在随机分界点之后为 true），
+// 断言 BinarySearchAnswer 总能精确定位该分界点
+func TestBinarySearchAnswerProperty(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+
+	for trial := 0; trial < 500; trial++ {
+		lo := r.Intn(200) - 100
+		hi := lo + r.Intn(200)
+		threshold := lo + r.Intn(hi-lo+2) // 允许 threshold == hi+1，即谓词恒为 false
+
+		pred := func(x int) bool {
+			return x >= threshold
+		}
+
+		got := BinarySearchAnswer(lo, hi, pred)
+		want := threshold
+		if want > hi+1 {
+			want = hi + 1
+		}
+
+		if got != want {
+			t.Fatalf("BinarySearchAnswer(%d, %d, threshold=%d) = %d, want %d", lo, hi, threshold, got, want)
+		}
+	}
+}
+
+// TestBinarySearchAnswerFloatProperty 对浮点版本做同样的性质测试，
+// 允许结果与真实分界点相差不超过 1e-6（比 eps 宽松）
+func TestBinarySearchAnswerFloatProperty(t *testing.T) {
+	r := rand.New(rand.NewSource(13))
+	const eps = 1e-9
+
+	for trial := 0; trial < 500; trial++ {
+		lo := r.Float64()*200 - 100
+		hi := lo + r.Float64()*200
+		threshold := lo + r.Float64()*(hi-lo)
+
+		pred := func(x float64) bool {
+			return x >= threshold
+		}
+
+		got := BinarySearchAnswerFloat(lo, hi, eps, pred)
+		if math.IsNaN(got) {
+			t.Fatalf("BinarySearchAnswerFloat(%v, %v, threshold=%v) returned NaN", lo, hi, threshold)
+		}
+		if diff := math.Abs(got - threshold); diff > 1e-6 {
+			t.Fatalf("BinarySearchAnswerFloat(%v, %v, threshold=%v) = %v, diff %v exceeds tolerance", lo, hi, threshold, got, diff)
+		}
+	}
+}
+
+// TestBinarySearchAnswerNeverTrue 覆盖谓词在整个区间内都不成立的情况
+func TestBinarySearchAnswerNeverTrue(t *testing.T) {
+	got := BinarySearchAnswer(1, 10, func(x int) bool { return false })
+	if want := 11; got != want {
+		t.Fatalf("BinarySearchAnswer with always-false pred = %d, want %d", got, want)
+	}
+}
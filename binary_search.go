@@ -2,101 +2,41 @@ package main
 
 import "fmt"
 
+// intLess 是 int 切片的默认 less 比较器，供下面的薄包装函数复用
+func intLess(a, b int) bool {
+	return a < b
+}
+
 // BinarySearch 在有序数组中查找目标值的索引
 // 如果找到返回索引，否则返回 -1
 // 时间复杂度: O(log n)
+// 是 BinarySearchBy 针对 int 的薄包装
 func BinarySearch(arr []int, target int) int {
-	left, right := 0, len(arr)-1
-	
-	for left <= right {
-		mid := left + (right-left)/2  // 防止溢出的写法
-		
-		if arr[mid] == target {
-			return mid
-		} else if arr[mid] < target {
-			left = mid + 1
-		} else {
-			right = mid - 1
-		}
-	}
-	
-	return -1
+	return BinarySearchBy(arr, intLess, target)
 }
 
 // BinarySearchFirst 查找第一个等于目标值的位置（处理重复元素）
+// 是 BinarySearchFirstBy 针对 int 的薄包装
 func BinarySearchFirst(arr []int, target int) int {
-	left, right := 0, len(arr)-1
-	result := -1
-	
-	for left <= right {
-		mid := left + (right-left)/2
-		
-		if arr[mid] == target {
-			result = mid
-			right = mid - 1  // 继续在左半部分查找
-		} else if arr[mid] < target {
-			left = mid + 1
-		} else {
-			right = mid - 1
-		}
-	}
-	
-	return result
+	return BinarySearchFirstBy(arr, intLess, target)
 }
 
 // BinarySearchLast 查找最后一个等于目标值的位置（处理重复元素）
+// 是 BinarySearchLastBy 针对 int 的薄包装
 func BinarySearchLast(arr []int, target int) int {
-	left, right := 0, len(arr)-1
-	result := -1
-	
-	for left <= right {
-		mid := left + (right-left)/2
-		
-		if arr[mid] == target {
-			result = mid
-			left = mid + 1  // 继续在右半部分查找
-		} else if arr[mid] < target {
-			left = mid + 1
-		} else {
-			right = mid - 1
-		}
-	}
-	
-	return result
+	return BinarySearchLastBy(arr, intLess, target)
 }
 
 // BinarySearchLowerBound 查找第一个大于等于目标值的位置
+// 是 BinarySearchLowerBoundBy 针对 int 的薄包装
 func BinarySearchLowerBound(arr []int, target int) int {
-	left, right := 0, len(arr)
-	
-	for left < right {
-		mid := left + (right-left)/2
-		
-		if arr[mid] < target {
-			left = mid + 1
-		} else {
-			right = mid
-		}
-	}
-	
-	return left
+	return BinarySearchLowerBoundBy(arr, intLess, target)
 }
 
 // BinarySearchUpperBound 查找第一个大于目标值的位置
+// 是 BinarySearchUpperBoundBy 针对 int 的薄包装
 func BinarySearchUpperBound(arr []int, target int) int {
-	left, right := 0, len(arr)
-	
-	for left < right {
-		mid := left + (right-left)/2
-		
-		if arr[mid] <= target {
-			left = mid + 1
-		} else {
-			right = mid
-		}
-	}
-	
-	return left
+	return BinarySearchUpperBoundBy(arr, intLess, target)
 }
 
 func main() {
@@ -139,5 +79,5 @@ func main() {
 	fmt.Printf("目标值 %d:\n", target)
 	fmt.Printf("  LowerBound (第一个 >= %d): 索引 %d\n", target, BinarySearchLowerBound(arr2, target))
 	fmt.Printf("  UpperBound (第一个 > %d): 索引 %d\n", target, BinarySearchUpperBound(arr2, target))
-	fmt.Printf("  元素 %d 的个数: %d\n", target, BinarySearchUpperBound(arr2, target)-BinarySearchLowerBound(arr2, target))
+	fmt.Printf("  元素 %d 的个数: %d\n", target, CountEqual(arr2, target))
 }
@@ -0,0 +1,124 @@
+package main
+
+// BinarySearchBy 使用自定义 less 比较器在有序切片中查找目标值的索引
+// 适用于 []int 以外的任意类型，例如字符串、结构体（按金额排序的订单等）
+// 如果找到返回索引，否则返回 -1
+func BinarySearchBy[T any](arr []T, less func(a, b T) bool, target T) int {
+	left, right := 0, len(arr)-1
+
+	for left <= right {
+		mid := left + (right-left)/2
+
+		switch {
+		case less(arr[mid], target):
+			left = mid + 1
+		case less(target, arr[mid]):
+			right = mid - 1
+		default:
+			return mid
+		}
+	}
+
+	return -1
+}
+
+// BinarySearchFirstBy 使用自定义 less 比较器查找第一个等于目标值的位置
+func BinarySearchFirstBy[T any](arr []T, less func(a, b T) bool, target T) int {
+	left, right := 0, len(arr)-1
+	result := -1
+
+	for left <= right {
+		mid := left + (right-left)/2
+
+		switch {
+		case less(arr[mid], target):
+			left = mid + 1
+		case less(target, arr[mid]):
+			right = mid - 1
+		default:
+			result = mid
+			right = mid - 1
+		}
+	}
+
+	return result
+}
+
+// BinarySearchLastBy 使用自定义 less 比较器查找最后一个等于目标值的位置
+func BinarySearchLastBy[T any](arr []T, less func(a, b T) bool, target T) int {
+	left, right := 0, len(arr)-1
+	result := -1
+
+	for left <= right {
+		mid := left + (right-left)/2
+
+		switch {
+		case less(arr[mid], target):
+			left = mid + 1
+		case less(target, arr[mid]):
+			right = mid - 1
+		default:
+			result = mid
+			left = mid + 1
+		}
+	}
+
+	return result
+}
+
+// BinarySearchLowerBoundBy 使用自定义 less 比较器查找第一个大于等于目标值的位置
+func BinarySearchLowerBoundBy[T any](arr []T, less func(a, b T) bool, target T) int {
+	left, right := 0, len(arr)
+
+	for left < right {
+		mid := left + (right-left)/2
+
+		if less(arr[mid], target) {
+			left = mid + 1
+		} else {
+			right = mid
+		}
+	}
+
+	return left
+}
+
+// BinarySearchUpperBoundBy 使用自定义 less 比较器查找第一个大于目标值的位置
+func BinarySearchUpperBoundBy[T any](arr []T, less func(a, b T) bool, target T) int {
+	left, right := 0, len(arr)
+
+	for left < right {
+		mid := left + (right-left)/2
+
+		if less(target, arr[mid]) {
+			right = mid
+		} else {
+			left = mid + 1
+		}
+	}
+
+	return left
+}
+
+// Search 是 sort.Interface 风格的二分查找：n 为元素个数，cmp(i) 返回
+// 元素 i 与目标值的三路比较结果（负数表示元素偏小，0 表示相等，正数表示偏大）
+// 要求 cmp 关于 i 单调，适用于不想先拷贝进 []int 就能查找的场景
+// （例如惰性生成的序列，或只暴露 sort.Interface 的容器）
+func Search(n int, cmp func(i int) int) int {
+	left, right := 0, n-1
+
+	for left <= right {
+		mid := left + (right-left)/2
+
+		switch {
+		case cmp(mid) < 0:
+			left = mid + 1
+		case cmp(mid) > 0:
+			right = mid - 1
+		default:
+			return mid
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,117 @@
+package main
+
+import "testing"
+
+// order 模拟按金额排序的订单记录，对应请求里 19 元订单的例子
+type order struct {
+	id     string
+	amount int
+}
+
+func orderAmountLess(a, b order) bool {
+	return a.amount < b.amount
+}
+
+func TestBinarySearchByStruct(t *testing.T) {
+	orders := []order{
+		{"o1", 5},
+		{"o2", 12},
+		{"o3", 19},
+		{"o4", 19},
+		{"o5", 23},
+	}
+
+	cases := []struct {
+		name      string
+		target    order
+		wantFound bool
+		wantFirst int
+		wantLast  int
+		wantLower int
+		wantUpper int
+	}{
+		{"match unique", order{amount: 12}, true, 1, 1, 1, 2},
+		{"match duplicate", order{amount: 19}, true, 2, 3, 2, 4},
+		{"below min", order{amount: 1}, false, -1, -1, 0, 0},
+		{"above max", order{amount: 99}, false, -1, -1, 5, 5},
+		{"gap between values", order{amount: 15}, false, -1, -1, 2, 2},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if idx := BinarySearchBy(orders, orderAmountLess, c.target); (idx != -1) != c.wantFound {
+				t.Errorf("BinarySearchBy(%v) found=%v, want %v", c.target, idx != -1, c.wantFound)
+			}
+			if got := BinarySearchFirstBy(orders, orderAmountLess, c.target); got != c.wantFirst {
+				t.Errorf("BinarySearchFirstBy(%v) = %d, want %d", c.target, got, c.wantFirst)
+			}
+			if got := BinarySearchLastBy(orders, orderAmountLess, c.target); got != c.wantLast {
+				t.Errorf("BinarySearchLastBy(%v) = %d, want %d", c.target, got, c.wantLast)
+			}
+			if got := BinarySearchLowerBoundBy(orders, orderAmountLess, c.target); got != c.wantLower {
+				t.Errorf("BinarySearchLowerBoundBy(%v) = %d, want %d", c.target, got, c.wantLower)
+			}
+			if got := BinarySearchUpperBoundBy(orders, orderAmountLess, c.target); got != c.wantUpper {
+				t.Errorf("BinarySearchUpperBoundBy(%v) = %d, want %d", c.target, got, c.wantUpper)
+			}
+		})
+	}
+}
+
+func TestBinarySearchByString(t *testing.T) {
+	words := []string{"apple", "banana", "cherry", "cherry", "date"}
+	less := func(a, b string) bool { return a < b }
+
+	if idx := BinarySearchBy(words, less, "cherry"); idx != 2 && idx != 3 {
+		t.Errorf("BinarySearchBy(%v, cherry) = %d, want 2 or 3", words, idx)
+	}
+	if idx := BinarySearchBy(words, less, "fig"); idx != -1 {
+		t.Errorf("BinarySearchBy(%v, fig) = %d, want -1", words, idx)
+	}
+	if got := BinarySearchFirstBy(words, less, "cherry"); got != 2 {
+		t.Errorf("BinarySearchFirstBy(%v, cherry) = %d, want 2", words, got)
+	}
+	if got := BinarySearchLastBy(words, less, "cherry"); got != 3 {
+		t.Errorf("BinarySearchLastBy(%v, cherry) = %d, want 3", words, got)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	arr := []int{1, 3, 5, 7, 7, 9, 11}
+	cmpFor := func(target int) func(int) int {
+		return func(i int) int {
+			switch {
+			case arr[i] < target:
+				return -1
+			case arr[i] > target:
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+
+	cases := []struct {
+		name      string
+		target    int
+		wantFound bool
+	}{
+		{"present unique", 5, true},
+		{"present duplicate", 7, true},
+		{"absent below", 0, false},
+		{"absent above", 12, false},
+		{"absent between", 4, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			idx := Search(len(arr), cmpFor(c.target))
+			if (idx != -1) != c.wantFound {
+				t.Errorf("Search(%d) found=%v, want %v", c.target, idx != -1, c.wantFound)
+			}
+			if c.wantFound && arr[idx] != c.target {
+				t.Errorf("Search(%d) = %d, arr[%d] = %d, want %d", c.target, idx, idx, arr[idx], c.target)
+			}
+		})
+	}
+}
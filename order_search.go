@@ -0,0 +1,121 @@
+package main
+
+// Order 表示切片的排序方向
+type Order int
+
+const (
+	// Ascending 表示升序（从小到大）
+	Ascending Order = iota
+	// Descending 表示降序（从大到小）
+	Descending
+)
+
+// IsSortedAsc 判断数组是否按升序排列
+func IsSortedAsc(arr []int) bool {
+	for i := 1; i < len(arr); i++ {
+		if arr[i] < arr[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSortedDesc 判断数组是否按降序排列
+func IsSortedDesc(arr []int) bool {
+	for i := 1; i < len(arr); i++ {
+		if arr[i] > arr[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// BinarySearchDesc 在降序数组中查找目标值的索引
+// 如果找到返回索引，否则返回 -1
+func BinarySearchDesc(arr []int, target int) int {
+	return BinarySearchBy(arr, descLess, target)
+}
+
+// BinarySearchFirstDesc 在降序数组中查找第一个等于目标值的位置
+// （即重复元素里下标最小的那个）
+func BinarySearchFirstDesc(arr []int, target int) int {
+	return BinarySearchFirstBy(arr, descLess, target)
+}
+
+// BinarySearchLastDesc 在降序数组中查找最后一个等于目标值的位置
+// （即重复元素里下标最大的那个）
+func BinarySearchLastDesc(arr []int, target int) int {
+	return BinarySearchLastBy(arr, descLess, target)
+}
+
+// BinarySearchLowerBoundDesc 在降序数组中查找第一个小于等于目标值的位置
+func BinarySearchLowerBoundDesc(arr []int, target int) int {
+	return BinarySearchLowerBoundBy(arr, descLess, target)
+}
+
+// BinarySearchUpperBoundDesc 在降序数组中查找第一个小于目标值的位置
+func BinarySearchUpperBoundDesc(arr []int, target int) int {
+	return BinarySearchUpperBoundBy(arr, descLess, target)
+}
+
+// descLess 是降序数组使用的 less 比较器：a 在 b 之前当且仅当 a > b
+func descLess(a, b int) bool {
+	return a > b
+}
+
+// SortedSlice 包装一个声称满足某个排序方向的 int 切片，记住其 Order 并把查找
+// 分派给对应方向的例程，避免调用方在升序/降序函数之间选错
+type SortedSlice struct {
+	arr   []int
+	order Order
+}
+
+// NewSortedSlice 用给定的 order 包装 arr，不做任何校验
+// 调用方需自行保证 arr 确实满足该顺序；如需校验，改用 NewValidatedSortedSlice
+func NewSortedSlice(arr []int, order Order) SortedSlice {
+	return SortedSlice{arr: arr, order: order}
+}
+
+// NewValidatedSortedSlice 用给定的 order 包装 arr，并用 IsSortedAsc / IsSortedDesc
+// 校验 arr 是否确实满足该顺序；ok 为 false 时返回的 SortedSlice 不应被使用
+func NewValidatedSortedSlice(arr []int, order Order) (s SortedSlice, ok bool) {
+	s = NewSortedSlice(arr, order)
+	return s, s.Validate()
+}
+
+// Validate 报告 s 包装的切片是否确实满足其记录的排序方向
+func (s SortedSlice) Validate() bool {
+	if s.order == Descending {
+		return IsSortedDesc(s.arr)
+	}
+	return IsSortedAsc(s.arr)
+}
+
+// Order 返回该切片记录的排序方向
+func (s SortedSlice) Order() Order {
+	return s.order
+}
+
+// Search 按照 s 记录的排序方向查找 target，语义等价于 BinarySearch / BinarySearchDesc
+func (s SortedSlice) Search(target int) int {
+	if s.order == Descending {
+		return BinarySearchDesc(s.arr, target)
+	}
+	return BinarySearch(s.arr, target)
+}
+
+// First 按照 s 记录的排序方向查找 target 第一次出现的位置
+func (s SortedSlice) First(target int) int {
+	if s.order == Descending {
+		return BinarySearchFirstDesc(s.arr, target)
+	}
+	return BinarySearchFirst(s.arr, target)
+}
+
+// Last 按照 s 记录的排序方向查找 target 最后一次出现的位置
+func (s SortedSlice) Last(target int) int {
+	if s.order == Descending {
+		return BinarySearchLastDesc(s.arr, target)
+	}
+	return BinarySearchLast(s.arr, target)
+}
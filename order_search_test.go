@@ -0,0 +1,140 @@
+package main
+
+import "testing"
+
+func TestIsSortedAscDesc(t *testing.T) {
+	cases := []struct {
+		name     string
+		arr      []int
+		wantAsc  bool
+		wantDesc bool
+	}{
+		{"empty", []int{}, true, true},
+		{"single element", []int{1}, true, true},
+		{"ascending", []int{1, 3, 3, 5, 7}, true, false},
+		{"descending", []int{7, 5, 3, 3, 1}, false, true},
+		{"unsorted", []int{1, 3, 2}, false, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := IsSortedAsc(c.arr); got != c.wantAsc {
+				t.Errorf("IsSortedAsc(%v) = %v, want %v", c.arr, got, c.wantAsc)
+			}
+			if got := IsSortedDesc(c.arr); got != c.wantDesc {
+				t.Errorf("IsSortedDesc(%v) = %v, want %v", c.arr, got, c.wantDesc)
+			}
+		})
+	}
+}
+
+func TestDescVariantsAgainstAscOnReversedInput(t *testing.T) {
+	asc := []int{1, 2, 4, 4, 4, 5, 6, 8, 8, 9}
+	desc := make([]int, len(asc))
+	for i, v := range asc {
+		desc[len(asc)-1-i] = v
+	}
+
+	for _, target := range []int{0, 1, 4, 5, 8, 9, 10} {
+		wantFound := BinarySearch(asc, target) != -1
+		if gotFound := BinarySearchDesc(desc, target) != -1; gotFound != wantFound {
+			t.Errorf("BinarySearchDesc(%v, %d) found=%v, want %v", desc, target, gotFound, wantFound)
+		}
+
+		wantFirstAsc := BinarySearchFirst(asc, target)
+		wantLastAsc := BinarySearchLast(asc, target)
+		gotFirstDesc := BinarySearchFirstDesc(desc, target)
+		gotLastDesc := BinarySearchLastDesc(desc, target)
+		if wantFirstAsc == -1 {
+			if gotFirstDesc != -1 || gotLastDesc != -1 {
+				t.Errorf("target %d absent from asc but desc found first=%d last=%d", target, gotFirstDesc, gotLastDesc)
+			}
+			continue
+		}
+		// desc 是 asc 的完全反转，所以 asc 中的 [first, last] 对应 desc 中的
+		// [len-1-last, len-1-first]
+		wantFirstDesc := len(asc) - 1 - wantLastAsc
+		wantLastDesc := len(asc) - 1 - wantFirstAsc
+		if gotFirstDesc != wantFirstDesc {
+			t.Errorf("BinarySearchFirstDesc(%v, %d) = %d, want %d", desc, target, gotFirstDesc, wantFirstDesc)
+		}
+		if gotLastDesc != wantLastDesc {
+			t.Errorf("BinarySearchLastDesc(%v, %d) = %d, want %d", desc, target, gotLastDesc, wantLastDesc)
+		}
+	}
+}
+
+func TestBinarySearchLowerUpperBoundDesc(t *testing.T) {
+	desc := []int{9, 8, 8, 6, 5, 4, 4, 4, 2, 1}
+
+	cases := []struct {
+		target        int
+		wantLowerDesc int
+		wantUpperDesc int
+	}{
+		{10, 0, 0},                // 比最大值还大：所有元素都 < target
+		{9, 0, 1},                 // 命中最大值
+		{7, 3, 3},                 // 落在 8 和 6 之间的空隙
+		{4, 5, 8},                 // 命中重复元素块
+		{1, 9, 10},                // 命中最小值
+		{0, len(desc), len(desc)}, // 比最小值还小：没有 <= target 的元素
+	}
+
+	for _, c := range cases {
+		if got := BinarySearchLowerBoundDesc(desc, c.target); got != c.wantLowerDesc {
+			t.Errorf("BinarySearchLowerBoundDesc(%v, %d) = %d, want %d", desc, c.target, got, c.wantLowerDesc)
+		}
+		if got := BinarySearchUpperBoundDesc(desc, c.target); got != c.wantUpperDesc {
+			t.Errorf("BinarySearchUpperBoundDesc(%v, %d) = %d, want %d", desc, c.target, got, c.wantUpperDesc)
+		}
+	}
+}
+
+func TestSortedSliceSearch(t *testing.T) {
+	asc := NewSortedSlice([]int{1, 2, 4, 4, 4, 5, 6, 8, 8, 9}, Ascending)
+	desc := NewSortedSlice([]int{9, 8, 8, 6, 5, 4, 4, 4, 2, 1}, Descending)
+
+	if got := asc.Search(6); got != 6 {
+		t.Errorf("asc.Search(6) = %d, want 6", got)
+	}
+	if got := desc.Search(6); got != 3 {
+		t.Errorf("desc.Search(6) = %d, want 3", got)
+	}
+	if got := asc.First(4); got != 2 {
+		t.Errorf("asc.First(4) = %d, want 2", got)
+	}
+	if got := desc.First(4); got != 5 {
+		t.Errorf("desc.First(4) = %d, want 5", got)
+	}
+	if got := asc.Last(4); got != 4 {
+		t.Errorf("asc.Last(4) = %d, want 4", got)
+	}
+	if got := desc.Last(4); got != 7 {
+		t.Errorf("desc.Last(4) = %d, want 7", got)
+	}
+	if got := asc.Order(); got != Ascending {
+		t.Errorf("asc.Order() = %v, want Ascending", got)
+	}
+	if got := desc.Order(); got != Descending {
+		t.Errorf("desc.Order() = %v, want Descending", got)
+	}
+}
+
+func TestSortedSliceValidate(t *testing.T) {
+	if s := NewSortedSlice([]int{1, 2, 3}, Ascending); !s.Validate() {
+		t.Error("expected ascending slice to validate as Ascending")
+	}
+	if s := NewSortedSlice([]int{3, 2, 1}, Ascending); s.Validate() {
+		t.Error("expected descending slice not to validate as Ascending")
+	}
+	if s := NewSortedSlice([]int{3, 2, 1}, Descending); !s.Validate() {
+		t.Error("expected descending slice to validate as Descending")
+	}
+
+	if _, ok := NewValidatedSortedSlice([]int{1, 2, 3}, Ascending); !ok {
+		t.Error("NewValidatedSortedSlice: expected ok=true for ascending input")
+	}
+	if _, ok := NewValidatedSortedSlice([]int{3, 2, 1}, Ascending); ok {
+		t.Error("NewValidatedSortedSlice: expected ok=false for descending input claimed as Ascending")
+	}
+}
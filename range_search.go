@@ -0,0 +1,36 @@
+package main
+
+// CountEqual 返回升序数组 arr 中等于 target 的元素个数
+func CountEqual(arr []int, target int) int {
+	lo, hi := EqualRange(arr, target)
+	return hi - lo
+}
+
+// EqualRange 返回升序数组 arr 中所有等于 target 的元素所在的左闭右开区间 [lo, hi)
+// 若 target 不存在，返回 lo == hi，且该值就是 target 应被插入的位置
+func EqualRange(arr []int, target int) (lo, hi int) {
+	lo = BinarySearchLowerBound(arr, target)
+	hi = BinarySearchUpperBound(arr, target)
+	return lo, hi
+}
+
+// CountInRange 返回升序数组 arr 中落在闭区间 [lo, hi] 内的元素个数
+func CountInRange(arr []int, lo, hi int) int {
+	if lo > hi {
+		return 0
+	}
+	start := BinarySearchLowerBound(arr, lo)
+	end := BinarySearchUpperBound(arr, hi)
+	return end - start
+}
+
+// RangeSlice 返回升序数组 arr 中落在闭区间 [lo, hi] 内元素组成的子切片
+// 空区间（包括 arr 为空、arr 的元素全小于 lo 或全大于 hi 的情况）返回长度为 0 的切片
+func RangeSlice(arr []int, lo, hi int) []int {
+	if lo > hi {
+		return arr[0:0]
+	}
+	start := BinarySearchLowerBound(arr, lo)
+	end := BinarySearchUpperBound(arr, hi)
+	return arr[start:end]
+}
@@ -0,0 +1,76 @@
+package main
+
+import "testing"
+
+func TestCountEqualAndEqualRange(t *testing.T) {
+	cases := []struct {
+		name      string
+		arr       []int
+		target    int
+		wantLo    int
+		wantHi    int
+		wantCount int
+	}{
+		{"empty slice", []int{}, 5, 0, 0, 0},
+		{"below min", []int{3, 3, 5, 7}, 1, 0, 0, 0},
+		{"above max", []int{3, 3, 5, 7}, 9, 4, 4, 0},
+		{"all duplicates, match", []int{4, 4, 4, 4}, 4, 0, 4, 4},
+		{"all duplicates, miss", []int{4, 4, 4, 4}, 1, 0, 0, 0},
+		{"single match", []int{1, 2, 4, 4, 4, 5, 6, 8, 8, 9}, 5, 5, 6, 1},
+		{"multiple matches", []int{1, 2, 4, 4, 4, 5, 6, 8, 8, 9}, 8, 7, 9, 2},
+		{"gap between duplicates", []int{1, 2, 4, 4, 4, 5, 6, 8, 8, 9}, 3, 2, 2, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if lo, hi := EqualRange(c.arr, c.target); lo != c.wantLo || hi != c.wantHi {
+				t.Errorf("EqualRange(%v, %d) = (%d, %d), want (%d, %d)", c.arr, c.target, lo, hi, c.wantLo, c.wantHi)
+			}
+			if got := CountEqual(c.arr, c.target); got != c.wantCount {
+				t.Errorf("CountEqual(%v, %d) = %d, want %d", c.arr, c.target, got, c.wantCount)
+			}
+		})
+	}
+}
+
+func TestCountInRangeAndRangeSlice(t *testing.T) {
+	cases := []struct {
+		name      string
+		arr       []int
+		lo, hi    int
+		wantCount int
+		wantSlice []int
+	}{
+		{"empty slice", []int{}, 1, 5, 0, []int{}},
+		{"inverted bounds", []int{1, 2, 3}, 5, 1, 0, []int{}},
+		{"range below min", []int{3, 4, 5}, -5, -1, 0, []int{}},
+		{"range above max", []int{3, 4, 5}, 10, 20, 0, []int{}},
+		{"all duplicates inside range", []int{4, 4, 4, 4}, 0, 10, 4, []int{4, 4, 4, 4}},
+		{"all duplicates outside range", []int{4, 4, 4, 4}, 5, 10, 0, []int{}},
+		{"partial overlap", []int{1, 2, 4, 4, 4, 5, 6, 8, 8, 9}, 4, 6, 5, []int{4, 4, 4, 5, 6}},
+		{"closed bounds match exactly", []int{1, 2, 4, 4, 4, 5, 6, 8, 8, 9}, 2, 9, 9, []int{2, 4, 4, 4, 5, 6, 8, 8, 9}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := CountInRange(c.arr, c.lo, c.hi); got != c.wantCount {
+				t.Errorf("CountInRange(%v, %d, %d) = %d, want %d", c.arr, c.lo, c.hi, got, c.wantCount)
+			}
+			if got := RangeSlice(c.arr, c.lo, c.hi); !equalIntSlices(got, c.wantSlice) {
+				t.Errorf("RangeSlice(%v, %d, %d) = %v, want %v", c.arr, c.lo, c.hi, got, c.wantSlice)
+			}
+		})
+	}
+}
+
+func equalIntSlices(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
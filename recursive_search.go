@@ -0,0 +1,98 @@
+package main
+
+// BinarySearchRecursive 是 BinarySearch 的递归实现
+// 如果找到返回索引，否则返回 -1
+func BinarySearchRecursive(arr []int, target int) int {
+	return bsRecursive(arr, target, 0, len(arr)-1)
+}
+
+func bsRecursive(arr []int, target, left, right int) int {
+	if left > right {
+		return -1
+	}
+
+	mid := left + (right-left)/2
+
+	if arr[mid] == target {
+		return mid
+	} else if arr[mid] < target {
+		return bsRecursive(arr, target, mid+1, right)
+	}
+	return bsRecursive(arr, target, left, mid-1)
+}
+
+// BinarySearchFirstRecursive 是 BinarySearchFirst 的递归实现
+func BinarySearchFirstRecursive(arr []int, target int) int {
+	return bsFirstRecursive(arr, target, 0, len(arr)-1, -1)
+}
+
+func bsFirstRecursive(arr []int, target, left, right, result int) int {
+	if left > right {
+		return result
+	}
+
+	mid := left + (right-left)/2
+
+	if arr[mid] == target {
+		return bsFirstRecursive(arr, target, left, mid-1, mid)
+	} else if arr[mid] < target {
+		return bsFirstRecursive(arr, target, mid+1, right, result)
+	}
+	return bsFirstRecursive(arr, target, left, mid-1, result)
+}
+
+// BinarySearchLastRecursive 是 BinarySearchLast 的递归实现
+func BinarySearchLastRecursive(arr []int, target int) int {
+	return bsLastRecursive(arr, target, 0, len(arr)-1, -1)
+}
+
+func bsLastRecursive(arr []int, target, left, right, result int) int {
+	if left > right {
+		return result
+	}
+
+	mid := left + (right-left)/2
+
+	if arr[mid] == target {
+		return bsLastRecursive(arr, target, mid+1, right, mid)
+	} else if arr[mid] < target {
+		return bsLastRecursive(arr, target, mid+1, right, result)
+	}
+	return bsLastRecursive(arr, target, left, mid-1, result)
+}
+
+// BinarySearchLowerBoundRecursive 是 BinarySearchLowerBound 的递归实现
+func BinarySearchLowerBoundRecursive(arr []int, target int) int {
+	return bsLowerBoundRecursive(arr, target, 0, len(arr))
+}
+
+func bsLowerBoundRecursive(arr []int, target, left, right int) int {
+	if left >= right {
+		return left
+	}
+
+	mid := left + (right-left)/2
+
+	if arr[mid] < target {
+		return bsLowerBoundRecursive(arr, target, mid+1, right)
+	}
+	return bsLowerBoundRecursive(arr, target, left, mid)
+}
+
+// BinarySearchUpperBoundRecursive 是 BinarySearchUpperBound 的递归实现
+func BinarySearchUpperBoundRecursive(arr []int, target int) int {
+	return bsUpperBoundRecursive(arr, target, 0, len(arr))
+}
+
+func bsUpperBoundRecursive(arr []int, target, left, right int) int {
+	if left >= right {
+		return left
+	}
+
+	mid := left + (right-left)/2
+
+	if arr[mid] <= target {
+		return bsUpperBoundRecursive(arr, target, mid+1, right)
+	}
+	return bsUpperBoundRecursive(arr, target, left, mid)
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// makeSortedArr 生成长度为 n 的有序数组，dupFactor > 1 时会制造大量重复值
+func makeSortedArr(n, dupFactor int) []int {
+	arr := make([]int, n)
+	val := 0
+	for i := range arr {
+		if i%dupFactor != 0 {
+			arr[i] = val
+		} else {
+			val++
+			arr[i] = val
+		}
+	}
+	return arr
+}
+
+var benchSizes = []int{1e3, 1e6, 1e8}
+
+func benchmarkPair(b *testing.B, iterative, recursive func([]int, int) int) {
+	for _, n := range benchSizes {
+		arr := makeSortedArr(n, 4)
+		hit := arr[n/2]
+		miss := arr[n-1] + 1
+
+		b.Run(benchName(n, "iterative", "hit"), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				iterative(arr, hit)
+			}
+		})
+		b.Run(benchName(n, "recursive", "hit"), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				recursive(arr, hit)
+			}
+		})
+		b.Run(benchName(n, "iterative", "miss"), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				iterative(arr, miss)
+			}
+		})
+		b.Run(benchName(n, "recursive", "miss"), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				recursive(arr, miss)
+			}
+		})
+	}
+}
+
+func benchName(n int, kind, mode string) string {
+	return kind + "/" + mode + "/n=" + itoa(n)
+}
+
+func itoa(n int) string {
+	if n == 0 {
+		return "0"
+	}
+	neg := n < 0
+	if neg {
+		n = -n
+	}
+	var buf [20]byte
+	i := len(buf)
+	for n > 0 {
+		i--
+		buf[i] = byte('0' + n%10)
+		n /= 10
+	}
+	if neg {
+		i--
+		buf[i] = '-'
+	}
+	return string(buf[i:])
+}
+
+func BenchmarkBinarySearch(b *testing.B) {
+	benchmarkPair(b, BinarySearch, BinarySearchRecursive)
+}
+
+func BenchmarkBinarySearchFirst(b *testing.B) {
+	benchmarkPair(b, BinarySearchFirst, BinarySearchFirstRecursive)
+}
+
+func BenchmarkBinarySearchLast(b *testing.B) {
+	benchmarkPair(b, BinarySearchLast, BinarySearchLastRecursive)
+}
+
+func BenchmarkBinarySearchLowerBound(b *testing.B) {
+	benchmarkPair(b, BinarySearchLowerBound, BinarySearchLowerBoundRecursive)
+}
+
+func BenchmarkBinarySearchUpperBound(b *testing.B) {
+	benchmarkPair(b, BinarySearchUpperBound, BinarySearchUpperBoundRecursive)
+}
+
+// TestRecursiveMatchesIterative 用随机有序数组交叉验证递归与迭代实现
+func TestRecursiveMatchesIterative(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	for trial := 0; trial < 200; trial++ {
+		n := r.Intn(200)
+		arr := make([]int, n)
+		val := 0
+		for i := range arr {
+			val += r.Intn(3)
+			arr[i] = val
+		}
+
+		target := -1
+		if n > 0 {
+			target = arr[r.Intn(n)]
+		}
+
+		if got, want := BinarySearchFirstRecursive(arr, target), BinarySearchFirst(arr, target); got != want {
+			t.Fatalf("BinarySearchFirstRecursive(%v, %d) = %d, want %d", arr, target, got, want)
+		}
+		if got, want := BinarySearchLastRecursive(arr, target), BinarySearchLast(arr, target); got != want {
+			t.Fatalf("BinarySearchLastRecursive(%v, %d) = %d, want %d", arr, target, got, want)
+		}
+		if got, want := BinarySearchLowerBoundRecursive(arr, target), BinarySearchLowerBound(arr, target); got != want {
+			t.Fatalf("BinarySearchLowerBoundRecursive(%v, %d) = %d, want %d", arr, target, got, want)
+		}
+		if got, want := BinarySearchUpperBoundRecursive(arr, target), BinarySearchUpperBound(arr, target); got != want {
+			t.Fatalf("BinarySearchUpperBoundRecursive(%v, %d) = %d, want %d", arr, target, got, want)
+		}
+	}
+}
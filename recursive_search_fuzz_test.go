@@ -0,0 +1,33 @@
+package main
+
+import "testing"
+
+// FuzzBinarySearchAgree 驱动随机整数流，构造有序数组后断言递归与迭代实现结果一致
+func FuzzBinarySearchAgree(f *testing.F) {
+	f.Add([]byte{1, 2, 2, 3, 5}, int32(2))
+	f.Add([]byte{}, int32(0))
+
+	f.Fuzz(func(t *testing.T, raw []byte, target int32) {
+		arr := make([]int, len(raw))
+		val := 0
+		for i, b := range raw {
+			val += int(b)
+			arr[i] = val
+		}
+
+		tgt := int(target)
+
+		if got, want := BinarySearchFirstRecursive(arr, tgt), BinarySearchFirst(arr, tgt); got != want {
+			t.Fatalf("BinarySearchFirstRecursive(%v, %d) = %d, want %d", arr, tgt, got, want)
+		}
+		if got, want := BinarySearchLastRecursive(arr, tgt), BinarySearchLast(arr, tgt); got != want {
+			t.Fatalf("BinarySearchLastRecursive(%v, %d) = %d, want %d", arr, tgt, got, want)
+		}
+		if got, want := BinarySearchLowerBoundRecursive(arr, tgt), BinarySearchLowerBound(arr, tgt); got != want {
+			t.Fatalf("BinarySearchLowerBoundRecursive(%v, %d) = %d, want %d", arr, tgt, got, want)
+		}
+		if got, want := BinarySearchUpperBoundRecursive(arr, tgt), BinarySearchUpperBound(arr, tgt); got != want {
+			t.Fatalf("BinarySearchUpperBoundRecursive(%v, %d) = %d, want %d", arr, tgt, got, want)
+		}
+	})
+}